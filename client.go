@@ -2,6 +2,7 @@ package webtenderApi
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
@@ -17,10 +18,13 @@ import (
 
 // Client represents an HTTP client with API key authentication and HMAC signing
 type Client struct {
-	httpClient *http.Client
-	apiKey     string
-	apiSecret  string
-	baseURL    string
+	httpClient    *http.Client
+	baseTransport http.RoundTripper
+	middlewares   []Middleware
+	apiKey        string
+	apiSecret     string
+	baseURL       string
+	retryPolicy   *RetryPolicy
 }
 
 // Config holds the configuration for the API client
@@ -29,6 +33,30 @@ type Config struct {
 	APISecret string
 	BaseURL   string
 	Timeout   time.Duration
+
+	// RetryPolicy controls how requests made through the *Context methods
+	// (and the resource sub-clients, which are built on them) are retried.
+	// Defaults to DefaultRetryPolicy() when nil.
+	RetryPolicy *RetryPolicy
+
+	// HTTPClient, when set, is copied and the copy is used instead of the
+	// client's default *http.Client, letting callers tune connection
+	// pooling, proxies, or supply their own Timeout.
+	HTTPClient *http.Client
+
+	// Transport, when set, becomes the base http.RoundTripper (e.g. for
+	// custom TLS RootCAs or an OpenTelemetry-instrumented transport).
+	// Further middleware registered via Client.Use wraps this. Ignored if
+	// HTTPClient is set and already has a non-nil Transport.
+	Transport http.RoundTripper
+
+	// Debug, when true, dumps every outgoing request and incoming response
+	// (with credentials redacted) to Logger.
+	Debug bool
+
+	// Logger receives debug output when Debug is true. It accepts a
+	// *slog.Logger or an io.Writer, and defaults to os.Stderr.
+	Logger any
 }
 
 type ApiResponse struct {
@@ -52,15 +80,45 @@ func NewClient(config Config) *Client {
 	if config.APISecret == "" {
 		config.APISecret = env("WEBTENDER_API_SECRET", "", true)
 	}
+	if config.RetryPolicy == nil {
+		config.RetryPolicy = DefaultRetryPolicy()
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: config.Timeout}
+	} else {
+		// Copy rather than mutate the caller's *http.Client in place: we're
+		// about to set .Transport below (and Use does so again later), and
+		// the caller may be holding onto and reusing the original pointer
+		// elsewhere.
+		copied := *httpClient
+		httpClient = &copied
+	}
+
+	baseTransport := httpClient.Transport
+	if baseTransport == nil {
+		baseTransport = config.Transport
+	}
+	if baseTransport == nil {
+		baseTransport = http.DefaultTransport
+	}
+	httpClient.Transport = baseTransport
+
+	client := &Client{
+		httpClient:    httpClient,
+		baseTransport: baseTransport,
+		apiKey:        config.APIKey,
+		apiSecret:     config.APISecret,
+		baseURL:       config.BaseURL,
+		retryPolicy:   config.RetryPolicy,
+	}
 
-	return &Client{
-		httpClient: &http.Client{
-			Timeout: config.Timeout,
-		},
-		apiKey:    config.APIKey,
-		apiSecret: config.APISecret,
-		baseURL:   config.BaseURL,
+	if config.Debug {
+		client.Use(debugMiddleware(newDebugLogger(config.Logger)))
 	}
+
+	return client
 }
 
 func NewClientDefaultsFromEnv() *Client {
@@ -93,23 +151,10 @@ func (c *Client) generateHMACSignature(method, fullUrl string, body []byte, time
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-// Request makes an authenticated HTTP request
+// NewRequest makes an authenticated HTTP request. It is a convenience
+// wrapper around NewRequestWithContext using context.Background().
 func (c *Client) NewRequest(method, path string, body []byte) (*http.Request, error) {
-	// Construct full URL
-	url := joinPaths(c.baseURL, path)
-
-	// Create request
-	req, err := http.NewRequest(method, url, bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Accept", "application/json")
-	if err := c.SignRequest(req); err != nil {
-		return nil, fmt.Errorf("failed to sign request: %w", err)
-	}
-
-	return req, nil
+	return c.NewRequestWithContext(context.Background(), method, path, body)
 }
 
 func (c *Client) SignRequest(req *http.Request) error {
@@ -154,76 +199,85 @@ func (c *Client) DeleteRequest(path string) (*http.Request, error) {
 	return c.NewRequest("DELETE", path, nil)
 }
 
-// Get makes a GET request to the API and returns the status code, data, and error
+// Get makes a GET request to the API and returns the status code, data, and
+// error. It is a convenience wrapper around GetContext using
+// context.Background(), and so is retried per the Client's RetryPolicy.
 func (c *Client) Get(path string) (*ApiResponse, error) {
-	req, err := c.GetRequest(path)
-	if err != nil {
-		return nil, err
-	}
-	return c.doRequest(req)
+	return c.GetContext(context.Background(), path)
 }
 
 func (c *Client) Post(path string, body []byte) (*ApiResponse, error) {
-	req, err := c.PostRequest(path, body)
-	if err != nil {
-		return nil, err
-	}
-	return c.doRequest(req)
+	return c.PostContext(context.Background(), path, body)
 }
 
 func (c *Client) Patch(path string, body []byte) (*ApiResponse, error) {
-	req, err := c.PatchRequest(path, body)
-	if err != nil {
-		return nil, err
-	}
-	return c.doRequest(req)
+	return c.PatchContext(context.Background(), path, body)
 }
 
 func (c *Client) Put(path string, body []byte) (*ApiResponse, error) {
-	req, err := c.PutRequest(path, body)
-	if err != nil {
-		return nil, err
-	}
-	return c.doRequest(req)
+	return c.PutContext(context.Background(), path, body)
 }
 
 func (c *Client) Delete(path string) (*ApiResponse, error) {
-	req, err := c.DeleteRequest(path)
+	return c.DeleteContext(context.Background(), path)
+}
+
+// rawResponse is the unparsed result of a round trip: a status code, header,
+// and the raw response body, before any json.Unmarshal decision is made
+// about it.
+type rawResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (c *Client) doRequestRaw(req *http.Request) (*rawResponse, error) {
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
-	return c.doRequest(req)
-}
+	defer resp.Body.Close()
 
-func (c *Client) doRequest(req *http.Request) (*ApiResponse, error) {
-	resp, err := c.httpClient.Do(req)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
+	return &rawResponse{status: resp.StatusCode, header: resp.Header, body: body}, nil
+}
+
+// responseError builds an error from a non-2xx status, preferring the
+// "message" field of a JSON error body when one is present.
+func responseError(status int, body []byte) error {
+	if status <= 299 {
+		return nil
+	}
+
+	var errBody struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &errBody); err == nil && errBody.Message != "" {
+		return fmt.Errorf("status: %d: %s", status, errBody.Message)
+	}
+
+	return fmt.Errorf("status: %d", status)
+}
+
+// buildApiResponse decodes a raw round trip into an ApiResponse, populating
+// Error from a non-2xx status.
+func buildApiResponse(raw *rawResponse) (*ApiResponse, error) {
 	apiResponse := ApiResponse{
-		Status: resp.StatusCode,
+		Status: raw.status,
 		Data:   map[string]interface{}{},
 		Error:  nil,
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		apiResponse.Error = err
-		return &apiResponse, err
-	}
-	err = json.Unmarshal(body, &apiResponse.Data)
-	if err != nil {
+	if err := json.Unmarshal(raw.body, &apiResponse.Data); err != nil {
 		apiResponse.Error = err
 		return &apiResponse, err
 	}
 
-	if apiResponse.Status > 299 {
-		apiResponse.Error = fmt.Errorf("status: %d", apiResponse.Status)
-		dataMap, ok := apiResponse.Data.(map[string]interface{})
-		if ok && dataMap["message"] != nil {
-			apiResponse.Error = fmt.Errorf("status: %d: %s", apiResponse.Status, dataMap["message"].(string))
-		}
+	if apiResponse.Error = responseError(raw.status, raw.body); apiResponse.Error != nil {
 		return &apiResponse, apiResponse.Error
 	}
 
@@ -234,6 +288,13 @@ func (c *Client) GetBaseURL() string {
 	return c.baseURL
 }
 
+// joinPaths resolves path against base. path is usually relative (e.g.
+// "/v1/servers"), but list endpoints also pass an absolute URL taken
+// verbatim from a Link response header's next-page link, which is returned
+// unchanged.
 func joinPaths(base, path string) string {
+	if strings.Contains(path, "://") {
+		return path
+	}
 	return strings.TrimSuffix(base, "/") + "/" + strings.TrimPrefix(path, "/")
 }
@@ -0,0 +1,117 @@
+package webtenderApi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// NewRequestWithContext is the context-aware counterpart to NewRequest,
+// threading ctx into http.NewRequestWithContext so callers can cancel,
+// deadline, or trace requests. opts applies any per-call RequestOption
+// overrides (extra headers, an idempotency key, disabling signing, ...).
+func (c *Client) NewRequestWithContext(ctx context.Context, method, path string, body []byte, opts ...RequestOption) (*http.Request, error) {
+	o := newRequestOptions(opts)
+
+	url := joinPaths(c.baseURL, path)
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	for key, values := range o.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if o.idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", o.idempotencyKey)
+	}
+
+	if !o.skipSigning {
+		if err := c.SignRequest(req); err != nil {
+			return nil, fmt.Errorf("failed to sign request: %w", err)
+		}
+	}
+
+	return req, nil
+}
+
+// withOptionTimeout derives a child context bounded by a WithTimeout option,
+// if one was given. The returned cancel func is always safe to defer.
+func withOptionTimeout(ctx context.Context, opts []RequestOption) (context.Context, context.CancelFunc) {
+	if o := newRequestOptions(opts); o.timeout > 0 {
+		return context.WithTimeout(ctx, o.timeout)
+	}
+	return ctx, func() {}
+}
+
+// GetContext makes a GET request to the API, honoring ctx cancellation and
+// any RequestOption overrides. It is retried per the Client's RetryPolicy.
+func (c *Client) GetContext(ctx context.Context, path string, opts ...RequestOption) (*ApiResponse, error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	raw, err := c.execute(ctx, http.MethodGet, path, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return buildApiResponse(raw)
+}
+
+// PostContext makes a POST request to the API, honoring ctx cancellation and
+// any RequestOption overrides.
+func (c *Client) PostContext(ctx context.Context, path string, body []byte, opts ...RequestOption) (*ApiResponse, error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	raw, err := c.execute(ctx, http.MethodPost, path, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return buildApiResponse(raw)
+}
+
+// PatchContext makes a PATCH request to the API, honoring ctx cancellation
+// and any RequestOption overrides. It is retried only when called with
+// WithIdempotencyKey.
+func (c *Client) PatchContext(ctx context.Context, path string, body []byte, opts ...RequestOption) (*ApiResponse, error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	raw, err := c.execute(ctx, http.MethodPatch, path, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return buildApiResponse(raw)
+}
+
+// PutContext makes a PUT request to the API, honoring ctx cancellation and
+// any RequestOption overrides. It is retried per the Client's RetryPolicy.
+func (c *Client) PutContext(ctx context.Context, path string, body []byte, opts ...RequestOption) (*ApiResponse, error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	raw, err := c.execute(ctx, http.MethodPut, path, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return buildApiResponse(raw)
+}
+
+// DeleteContext makes a DELETE request to the API, honoring ctx cancellation
+// and any RequestOption overrides. It is retried per the Client's
+// RetryPolicy.
+func (c *Client) DeleteContext(ctx context.Context, path string, opts ...RequestOption) (*ApiResponse, error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	raw, err := c.execute(ctx, http.MethodDelete, path, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return buildApiResponse(raw)
+}
@@ -0,0 +1,104 @@
+package webtenderApi
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Database mirrors the v1 /databases resource.
+type Database struct {
+	ID        string    `json:"id"`
+	ServerID  string    `json:"server_id"`
+	Name      string    `json:"name"`
+	Engine    string    `json:"engine"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateDatabaseRequest is the payload accepted by DatabasesClient.Create.
+type CreateDatabaseRequest struct {
+	ServerID string `json:"server_id"`
+	Name     string `json:"name"`
+	Engine   string `json:"engine"`
+}
+
+// DatabaseListOptions controls pagination of DatabasesClient.List and
+// DatabasesClient.ListAll.
+type DatabaseListOptions struct {
+	Page    int
+	PerPage int
+}
+
+func (o DatabaseListOptions) queryString() string {
+	q := url.Values{}
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	return q.Encode()
+}
+
+// DatabasesClient groups the database-related endpoints of the v1 API.
+type DatabasesClient struct {
+	client *Client
+}
+
+// Databases returns a client scoped to the /v1/databases resource.
+func (c *Client) Databases() *DatabasesClient {
+	return &DatabasesClient{client: c}
+}
+
+// List fetches a single page of databases.
+func (d *DatabasesClient) List(ctx context.Context, opts DatabaseListOptions) ([]Database, error) {
+	page, err := d.ListPage(ctx, opts.Page, opts.PerPage)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// ListPage fetches one page of databases along with its Link-header
+// pagination state.
+func (d *DatabasesClient) ListPage(ctx context.Context, page, perPage int) (*Page[Database], error) {
+	return doPage[Database](d.client, ctx, "GET", d.listPath(DatabaseListOptions{Page: page, PerPage: perPage}), nil)
+}
+
+// ListAll returns an Iterator that lazily walks every page of databases,
+// starting from opts.Page (or the first page, if unset), following each
+// response's Link header to find the next one.
+func (d *DatabasesClient) ListAll(ctx context.Context, opts DatabaseListOptions) *Iterator[Database] {
+	return newIterator(ctx, d.listPath(opts), func(ctx context.Context, path string) (*Page[Database], error) {
+		return doPage[Database](d.client, ctx, "GET", path, nil)
+	})
+}
+
+func (d *DatabasesClient) listPath(opts DatabaseListOptions) string {
+	path := "/v1/databases"
+	if qs := opts.queryString(); qs != "" {
+		path += "?" + qs
+	}
+	return path
+}
+
+func (d *DatabasesClient) Get(ctx context.Context, id string) (*Database, error) {
+	return Do[Database](d.client, ctx, "GET", "/v1/databases/"+id, nil)
+}
+
+func (d *DatabasesClient) Create(ctx context.Context, create CreateDatabaseRequest) (*Database, error) {
+	body, err := json.Marshal(create)
+	if err != nil {
+		return nil, err
+	}
+	return Do[Database](d.client, ctx, "POST", "/v1/databases", body)
+}
+
+func (d *DatabasesClient) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteContext(ctx, "/v1/databases/"+id)
+	return err
+}
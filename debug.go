@@ -0,0 +1,103 @@
+package webtenderApi
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"strings"
+	"time"
+)
+
+// redactedHeaders are stripped from debug dumps so enabling Config.Debug
+// never leaks credentials into logs.
+var redactedHeaders = []string{"X-Api-Key", "X-Signature"}
+
+// debugLogger adapts either a *slog.Logger or an io.Writer, the two forms
+// Config.Logger accepts, to a single Printf-style sink.
+type debugLogger struct {
+	slog *slog.Logger
+	w    io.Writer
+}
+
+func newDebugLogger(logger any) *debugLogger {
+	switch l := logger.(type) {
+	case *slog.Logger:
+		return &debugLogger{slog: l}
+	case io.Writer:
+		return &debugLogger{w: l}
+	default:
+		return &debugLogger{w: os.Stderr}
+	}
+}
+
+func (d *debugLogger) Printf(format string, args ...any) {
+	if d.slog != nil {
+		d.slog.Debug(fmt.Sprintf(format, args...))
+		return
+	}
+	fmt.Fprintf(d.w, format+"\n", args...)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// debugMiddleware dumps each outgoing request and incoming response via
+// httputil.DumpRequestOut/DumpResponse, redacting credentials and skipping
+// multipart bodies, along with the request's duration.
+func debugMiddleware(logger *debugLogger) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+
+			reqDump, err := httputil.DumpRequestOut(req, shouldDumpBody(req.Header))
+			if err != nil {
+				reqDump = []byte(fmt.Sprintf("<failed to dump request: %v>", err))
+			}
+
+			resp, rtErr := next.RoundTrip(req)
+			duration := time.Since(start)
+
+			if rtErr != nil {
+				logger.Printf("--- request (%s) ---\n%s\n--- error after %s ---\n%v", req.Method, redact(reqDump), duration, rtErr)
+				return resp, rtErr
+			}
+
+			respDump, err := httputil.DumpResponse(resp, shouldDumpBody(resp.Header))
+			if err != nil {
+				respDump = []byte(fmt.Sprintf("<failed to dump response: %v>", err))
+			}
+
+			logger.Printf("--- request (%s) ---\n%s\n--- response (%s) ---\n%s", req.Method, redact(reqDump), duration, redact(respDump))
+			return resp, rtErr
+		})
+	}
+}
+
+// shouldDumpBody skips body dumping for multipart/form-data, where the body
+// may be a large file upload.
+func shouldDumpBody(header http.Header) bool {
+	return !strings.HasPrefix(header.Get("Content-Type"), "multipart/form-data")
+}
+
+// redact blanks out the value of any redactedHeaders line in a
+// DumpRequestOut/DumpResponse dump.
+func redact(dump []byte) []byte {
+	lines := bytes.Split(dump, []byte("\r\n"))
+	for i, line := range lines {
+		for _, h := range redactedHeaders {
+			prefix := h + ":"
+			if len(line) >= len(prefix) && strings.EqualFold(string(line[:len(prefix)]), prefix) {
+				lines[i] = []byte(prefix + " [redacted]")
+				break
+			}
+		}
+	}
+	return bytes.Join(lines, []byte("\r\n"))
+}
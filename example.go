@@ -1,6 +1,7 @@
 package webtenderApi
 
 import (
+	"context"
 	"fmt"
 	"log"
 	// you will need this
@@ -12,41 +13,28 @@ import (
 func ExampleUsage() {
 	// Requires environment variables for WEBTENDER_API_KEY and WEBTENDER_API_SECRET
 	client := NewClientDefaultsFromEnv()
+	ctx := context.Background()
 
-	// Example GET request to list servers (paginated)
-	serverListResponse, err := client.Get("/v1/servers")
+	// Example request to list servers (paginated)
+	servers, err := client.Servers().List(ctx, ServerListOptions{})
 	if err != nil {
-		log.Fatalf("GET request failed: %v", err)
+		log.Fatalf("listing servers failed: %v", err)
 	}
-	if serverListResponse.Status != 200 {
-		log.Fatalf("GET request failed: %v", serverListResponse.Error)
+	fmt.Printf("Found %d servers\n", len(servers))
+	for _, server := range servers {
+		fmt.Printf("Server: %s\n", server.ID)
 	}
 
-	serverList := serverListResponse.Data.([]map[string]interface{})
-	fmt.Printf("Found %d servers\n", len(serverList))
-	for _, server := range serverList {
-		fmt.Printf("Server: %s\n", server["id"])
-	}
-
-	// Example POST request to create a new server
-	createServerResponse, err := client.Post("/v1/servers", []byte(`{"name": "test-server"}`))
+	// Example request to create a new server
+	server, err := client.Servers().Create(ctx, CreateServerRequest{Name: "test-server"})
 	if err != nil {
-		log.Fatalf("POST request failed: %v", err)
-	}
-	if createServerResponse.Status != 200 {
-		log.Fatalf("POST request failed: %v", createServerResponse.Error)
+		log.Fatalf("creating server failed: %v", err)
 	}
-	serverId := createServerResponse.Data.(map[string]interface{})["id"]
-	fmt.Printf("Server created: %s\n", serverId)
+	fmt.Printf("Server created: %s\n", server.ID)
 
-	// Example DELETE request to delete a server by ID
-	deleteServerResponse, err := client.Delete(fmt.Sprintf("/v1/servers/%s", serverId))
-	if err != nil {
-		log.Fatalf("GET request failed: %v", err)
-	}
-	if deleteServerResponse.Status > 299 {
-		log.Fatalf("GET request failed: %v", deleteServerResponse.Error)
+	// Example request to delete a server by ID
+	if err := client.Servers().Delete(ctx, server.ID); err != nil {
+		log.Fatalf("deleting server failed: %v", err)
 	}
-	fmt.Printf("Server deleted: %s\n", serverId)
-
+	fmt.Printf("Server deleted: %s\n", server.ID)
 }
@@ -0,0 +1,25 @@
+package webtenderApi
+
+import "net/http"
+
+// Middleware wraps a RoundTripper to layer in cross-cutting behavior
+// (logging, metrics, caching, ...) without forking the client.
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+// Use registers middleware on the client's transport chain, in the order
+// requests pass through them: mws[0] sees the request first and the
+// response last. Each call rebuilds the chain on top of the configured base
+// transport (Config.Transport / Config.HTTPClient's Transport, or
+// http.DefaultTransport).
+func (c *Client) Use(mws ...Middleware) {
+	c.middlewares = append(c.middlewares, mws...)
+	c.httpClient.Transport = c.buildTransport()
+}
+
+func (c *Client) buildTransport() http.RoundTripper {
+	rt := c.baseTransport
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		rt = c.middlewares[i](rt)
+	}
+	return rt
+}
@@ -0,0 +1,255 @@
+package webtenderApi
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// FileUpload is a single file to be streamed as part of a multipart/form-data
+// request, e.g. an SSH key, a TLS certificate, or a backup archive.
+type FileUpload struct {
+	Filename string
+	Content  io.Reader
+}
+
+// buildMultipartBody encodes fields and files into a multipart/form-data
+// body. The body is buffered in memory, the same tradeoff SignRequest
+// already makes for every other request, so the whole thing can be HMAC
+// signed. For large files (e.g. a backup archive being restored) where
+// buffering the whole body is too costly, use PostMultipartStreamContext
+// instead.
+func buildMultipartBody(fields map[string]string, files map[string]FileUpload) ([]byte, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, "", fmt.Errorf("failed to write multipart field %q: %w", key, err)
+		}
+	}
+
+	for field, file := range files {
+		part, err := writer.CreateFormFile(field, file.Filename)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create multipart file %q: %w", field, err)
+		}
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return nil, "", fmt.Errorf("failed to copy multipart file %q: %w", field, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+
+	return buf.Bytes(), writer.FormDataContentType(), nil
+}
+
+// NewMultipartRequestWithContext builds a signed POST request with a
+// multipart/form-data body containing fields and files.
+func (c *Client) NewMultipartRequestWithContext(ctx context.Context, path string, fields map[string]string, files map[string]FileUpload, opts ...RequestOption) (*http.Request, error) {
+	body, contentType, err := buildMultipartBody(fields, files)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, WithHeader("Content-Type", contentType))
+	return c.NewRequestWithContext(ctx, http.MethodPost, path, body, opts...)
+}
+
+// PostMultipartContext uploads fields and files as a multipart/form-data
+// request, honoring ctx cancellation and any RequestOption overrides.
+// Multipart uploads are POSTs and so are not retried.
+func (c *Client) PostMultipartContext(ctx context.Context, path string, fields map[string]string, files map[string]FileUpload, opts ...RequestOption) (*ApiResponse, error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	req, err := c.NewMultipartRequestWithContext(ctx, path, fields, files, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := c.doRequestRaw(req)
+	if err != nil {
+		return nil, err
+	}
+	return buildApiResponse(raw)
+}
+
+// PostMultipart uploads fields and files as a multipart/form-data request.
+// It is a convenience wrapper around PostMultipartContext using
+// context.Background().
+func (c *Client) PostMultipart(path string, fields map[string]string, files map[string]FileUpload) (*ApiResponse, error) {
+	return c.PostMultipartContext(context.Background(), path, fields, files)
+}
+
+// SeekableFileUpload is a FileUpload whose Content can be rewound (e.g. an
+// *os.File), required for PostMultipartStreamContext: the body has to be
+// hashed once to compute X-Content-SHA256, then read again to send it.
+type SeekableFileUpload struct {
+	Filename string
+	Content  io.ReadSeeker
+}
+
+// PostMultipartStreamContext uploads fields and files the same way
+// PostMultipartContext does, but streams the multipart body directly to the
+// transport instead of buffering it into memory first, for uploads (backup
+// archive restores, in particular) too large to comfortably hold twice
+// over. Because that means the body can't be read up front to compute
+// SignRequest's usual body-hash HMAC, the request is instead signed over
+// just method+URL+timestamp, with the body's digest carried separately in
+// X-Content-SHA256.
+func (c *Client) PostMultipartStreamContext(ctx context.Context, path string, fields map[string]string, files map[string]SeekableFileUpload, opts ...RequestOption) (*ApiResponse, error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	boundary, err := randomBoundary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate multipart boundary: %w", err)
+	}
+
+	digest, err := hashMultipartBody(boundary, fields, files)
+	if err != nil {
+		return nil, err
+	}
+	if err := rewindFiles(files); err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeMultipartBody(pw, boundary, fields, files))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, joinPaths(c.baseURL, path), pr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	o := newRequestOptions(opts)
+	for key, values := range o.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+	req.Header.Set("X-Content-SHA256", digest)
+	if o.idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", o.idempotencyKey)
+	}
+
+	if !o.skipSigning {
+		c.signRequestStreaming(req)
+	}
+
+	raw, err := c.doRequestRaw(req)
+	if err != nil {
+		return nil, err
+	}
+	return buildApiResponse(raw)
+}
+
+// PostMultipartStream uploads fields and files without buffering the whole
+// body in memory. It is a convenience wrapper around
+// PostMultipartStreamContext using context.Background().
+func (c *Client) PostMultipartStream(path string, fields map[string]string, files map[string]SeekableFileUpload) (*ApiResponse, error) {
+	return c.PostMultipartStreamContext(context.Background(), path, fields, files)
+}
+
+// signRequestStreaming signs req the same way SignRequest does, but over
+// method+URL+timestamp only, skipping the body-hash SignRequest normally
+// folds in -- req's body is a stream that can't be read twice. Pair this
+// with an X-Content-SHA256 header carrying the body's digest separately.
+func (c *Client) signRequestStreaming(req *http.Request) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature := c.generateHMACSignature(req.Method, req.URL.String(), nil, timestamp)
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("X-Timestamp", timestamp)
+	req.Header.Set("X-Signature", signature)
+}
+
+// randomBoundary returns a multipart boundary suitable for SetBoundary. It
+// has to be generated up front (rather than left to multipart.Writer to
+// pick) so the hashing pass and the send pass produce byte-identical
+// output.
+func randomBoundary() (string, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf[:]), nil
+}
+
+func rewindFiles(files map[string]SeekableFileUpload) error {
+	for field, file := range files {
+		if _, err := file.Content.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("failed to rewind file %q: %w", field, err)
+		}
+	}
+	return nil
+}
+
+func hashMultipartBody(boundary string, fields map[string]string, files map[string]SeekableFileUpload) (string, error) {
+	h := sha256.New()
+	if err := writeMultipartBody(h, boundary, fields, files); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeMultipartBody encodes fields and files into w using the given fixed
+// boundary. It's called twice per stream upload -- once against a
+// sha256.Hash to compute X-Content-SHA256, once against the request's pipe
+// to actually send -- so both passes must be reproducible byte-for-byte.
+// That's why the boundary is fixed rather than left to multipart.Writer's
+// default, and why fields/files are visited in sorted key order rather than
+// Go's randomized map iteration order.
+func writeMultipartBody(w io.Writer, boundary string, fields map[string]string, files map[string]SeekableFileUpload) error {
+	writer := multipart.NewWriter(w)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return fmt.Errorf("failed to set multipart boundary: %w", err)
+	}
+
+	for _, key := range sortedKeys(fields) {
+		if err := writer.WriteField(key, fields[key]); err != nil {
+			return fmt.Errorf("failed to write multipart field %q: %w", key, err)
+		}
+	}
+
+	for _, field := range sortedKeys(files) {
+		file := files[field]
+		part, err := writer.CreateFormFile(field, file.Filename)
+		if err != nil {
+			return fmt.Errorf("failed to create multipart file %q: %w", field, err)
+		}
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return fmt.Errorf("failed to copy multipart file %q: %w", field, err)
+		}
+	}
+
+	return writer.Close()
+}
+
+// sortedKeys returns m's keys in sorted order, so callers that must produce
+// the same output across repeated map iterations don't rely on Go's
+// randomized map order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
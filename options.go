@@ -0,0 +1,58 @@
+package webtenderApi
+
+import (
+	"net/http"
+	"time"
+)
+
+// requestOptions holds the per-call overrides configured via RequestOption.
+type requestOptions struct {
+	headers        http.Header
+	idempotencyKey string
+	skipSigning    bool
+	timeout        time.Duration
+}
+
+// RequestOption customizes a single request, so callers don't have to fall
+// back to raw http.Request construction just to add one header.
+type RequestOption func(*requestOptions)
+
+// WithHeader adds an extra header to the outgoing request.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// WithIdempotencyKey sets the Idempotency-Key header on the request.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(o *requestOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// WithoutSigning skips HMAC signing for this request.
+func WithoutSigning() RequestOption {
+	return func(o *requestOptions) {
+		o.skipSigning = true
+	}
+}
+
+// WithTimeout bounds this single request to d, independent of the Client's
+// overall http.Client timeout.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = d
+	}
+}
+
+func newRequestOptions(opts []RequestOption) requestOptions {
+	var o requestOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
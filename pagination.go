@@ -0,0 +1,149 @@
+package webtenderApi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Page is one fetched page of a list endpoint. The WebTender API returns a
+// bare top-level JSON array for list endpoints rather than an envelope
+// object, so pagination state comes entirely from the response's Link
+// header, e.g. `<.../v1/servers?page=2>; rel="next"` (RFC 8288).
+type Page[T any] struct {
+	Items   []T
+	NextURL string
+}
+
+// HasNext reports whether the response advertised a next page.
+func (p *Page[T]) HasNext() bool {
+	return p.NextURL != ""
+}
+
+// doPage sends a request and decodes its bare JSON array body into a Page,
+// reading the next page's URL from the Link response header.
+func doPage[T any](c *Client, ctx context.Context, method, path string, body []byte, opts ...RequestOption) (*Page[T], error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	raw, err := c.execute(ctx, method, path, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := responseError(raw.status, raw.body); err != nil {
+		return nil, err
+	}
+
+	var items []T
+	if err := json.Unmarshal(raw.body, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode response into []%T: %w", items, err)
+	}
+
+	return &Page[T]{Items: items, NextURL: parseNextLink(raw.header.Get("Link"))}, nil
+}
+
+// parseNextLink extracts the rel="next" URL from an RFC 8288 Link header,
+// returning "" if the header is absent or has no next link.
+func parseNextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		url := strings.TrimSpace(parts[0])
+		url = strings.TrimPrefix(url, "<")
+		url = strings.TrimSuffix(url, ">")
+
+		for _, param := range parts[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return url
+			}
+		}
+	}
+	return ""
+}
+
+// Iterator lazily walks a paginated list endpoint, following the Link
+// header's next-page URL on demand as Next is called.
+type Iterator[T any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, path string) (*Page[T], error)
+
+	nextPath string
+	started  bool
+
+	items []T
+	index int
+
+	done bool
+	err  error
+}
+
+func newIterator[T any](ctx context.Context, firstPath string, fetch func(context.Context, string) (*Page[T], error)) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, fetch: fetch, nextPath: firstPath}
+}
+
+// Next advances the iterator, fetching the next page when the current one
+// is exhausted. It returns false once iteration is complete or an error
+// occurs; call Err to distinguish the two.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	for it.index >= len(it.items) {
+		if it.started && it.nextPath == "" {
+			it.done = true
+			return false
+		}
+		it.started = true
+
+		page, err := it.fetch(it.ctx, it.nextPath)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.items = page.Items
+		it.index = 0
+		it.nextPath = page.NextURL
+
+		if len(it.items) == 0 {
+			it.done = true
+			return false
+		}
+	}
+
+	it.index++
+	return true
+}
+
+// Value returns the item produced by the most recent call to Next.
+func (it *Iterator[T]) Value() T {
+	return it.items[it.index-1]
+}
+
+// Err returns the first error encountered while fetching pages, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Collect materializes the iterator's remaining items into a slice,
+// stopping once it has collected max items. max <= 0 means unlimited,
+// which risks unbounded memory use on a large or unbounded list.
+func (it *Iterator[T]) Collect(max int) ([]T, error) {
+	var out []T
+	for it.Next() {
+		out = append(out, it.Value())
+		if max > 0 && len(out) >= max {
+			break
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,100 @@
+package webtenderApi
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseNextLink(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{"empty header", "", ""},
+		{
+			"single next link",
+			`<https://api.webtender.host/api/v1/servers?page=2>; rel="next"`,
+			"https://api.webtender.host/api/v1/servers?page=2",
+		},
+		{
+			"next and last",
+			`<https://api.webtender.host/api/v1/servers?page=2>; rel="next", <https://api.webtender.host/api/v1/servers?page=5>; rel="last"`,
+			"https://api.webtender.host/api/v1/servers?page=2",
+		},
+		{
+			"last page, no next",
+			`<https://api.webtender.host/api/v1/servers?page=1>; rel="prev"`,
+			"",
+		},
+		{
+			"malformed segment without rel",
+			`<https://api.webtender.host/api/v1/servers?page=2>`,
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := parseNextLink(tc.header); got != tc.want {
+				t.Errorf("parseNextLink(%q) = %q, want %q", tc.header, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestIteratorStartsOnFirstPage is a regression test for an off-by-one
+// where Next incremented a page counter before the very first fetch,
+// skipping the caller's requested starting page entirely.
+func TestIteratorStartsOnFirstPage(t *testing.T) {
+	var fetchedPaths []string
+	pages := map[string]*Page[int]{
+		"/v1/servers?page=1": {Items: []int{1, 2}, NextURL: "/v1/servers?page=2"},
+		"/v1/servers?page=2": {Items: []int{3}, NextURL: ""},
+	}
+
+	it := newIterator(context.Background(), "/v1/servers?page=1", func(_ context.Context, path string) (*Page[int], error) {
+		fetchedPaths = append(fetchedPaths, path)
+		return pages[path], nil
+	})
+
+	got, err := it.Collect(0)
+	if err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	if len(fetchedPaths) == 0 || fetchedPaths[0] != "/v1/servers?page=1" {
+		t.Fatalf("expected the first fetch to use the requested starting page, got %v", fetchedPaths)
+	}
+}
+
+// TestIteratorStopsOnEmptyPage covers the case where the Link header gives
+// no next URL and the API also starts returning empty pages.
+func TestIteratorStopsOnEmptyPage(t *testing.T) {
+	calls := 0
+	it := newIterator(context.Background(), "/v1/servers", func(_ context.Context, path string) (*Page[int], error) {
+		calls++
+		return &Page[int]{}, nil
+	})
+
+	items, err := it.Collect(0)
+	if err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+	if len(items) != 0 {
+		t.Fatalf("expected no items, got %v", items)
+	}
+	if calls != 1 {
+		t.Fatalf("expected exactly one fetch against an empty first page, got %d", calls)
+	}
+}
@@ -0,0 +1,33 @@
+package webtenderApi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Do sends a request built from method, path, and body, decoding the JSON
+// response directly into T rather than the interface{}-typed
+// ApiResponse.Data. It goes through the same retry-aware execution path as
+// the *Context methods, so it is used by the resource sub-clients (Servers,
+// Sites, Databases) instead of their own request/response plumbing.
+func Do[T any](c *Client, ctx context.Context, method, path string, body []byte, opts ...RequestOption) (*T, error) {
+	ctx, cancel := withOptionTimeout(ctx, opts)
+	defer cancel()
+
+	raw, err := c.execute(ctx, method, path, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := responseError(raw.status, raw.body); err != nil {
+		return nil, err
+	}
+
+	var out T
+	if err := json.Unmarshal(raw.body, &out); err != nil {
+		return nil, fmt.Errorf("failed to decode response into %T: %w", out, err)
+	}
+
+	return &out, nil
+}
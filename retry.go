@@ -0,0 +1,172 @@
+package webtenderApi
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the *Context request methods (and the resource
+// sub-clients built on them) retry failed requests. Methods considered
+// idempotent by HTTP semantics (GET, PUT, DELETE) are retried automatically;
+// PATCH is only retried when the caller set an Idempotency-Key via
+// WithIdempotencyKey, since the API cannot otherwise tell a resend from a
+// second, distinct change. POST is never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	MaxAttempts int
+	// BaseDelay and MaxDelay bound the exponential backoff (full jitter).
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// RetryableStatusCodes are the HTTP statuses, beyond network errors,
+	// that trigger a retry.
+	RetryableStatusCodes map[int]bool
+	// OnRetry, if set, is called after each failed attempt that will be
+	// retried, for logging or metrics.
+	OnRetry func(attempt int, err error)
+}
+
+// DefaultRetryPolicy returns the client's default retry behavior: up to 5
+// attempts, 500ms base backoff capped at 30s, retrying 429, 500, 502, 503
+// and 504.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		RetryableStatusCodes: map[int]bool{
+			http.StatusTooManyRequests:     true,
+			http.StatusInternalServerError: true,
+			http.StatusBadGateway:          true,
+			http.StatusServiceUnavailable:  true,
+			http.StatusGatewayTimeout:      true,
+		},
+	}
+}
+
+// backoff computes the delay before the next attempt using exponential
+// backoff with full jitter, honoring retryAfter (parsed from a Retry-After
+// header) when it's set. A zero-value BaseDelay or MaxDelay (e.g. from a
+// caller-constructed RetryPolicy that only sets MaxAttempts) falls back to
+// DefaultRetryPolicy's, since rand.Int63n panics given a non-positive bound.
+func (p *RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	base, cap := p.BaseDelay, p.MaxDelay
+	if base <= 0 || cap <= 0 {
+		defaults := DefaultRetryPolicy()
+		if base <= 0 {
+			base = defaults.BaseDelay
+		}
+		if cap <= 0 {
+			cap = defaults.MaxDelay
+		}
+	}
+
+	max := base * time.Duration(uint64(1)<<uint(attempt-1))
+	if max <= 0 || max > cap {
+		max = cap
+	}
+
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// isRetryableMethod reports whether method may be retried under this policy.
+func isRetryableMethod(method string, hasIdempotencyKey bool) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPatch:
+		return hasIdempotencyKey
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value, which the HTTP spec
+// allows to be either a number of seconds or an HTTP-date.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(header); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// execute builds and sends a request, retrying per c.retryPolicy on
+// transient network errors and retryable status codes. Because SignRequest
+// binds the HMAC signature to a timestamp, each attempt builds (and signs) a
+// fresh request rather than resending the first one.
+func (c *Client) execute(ctx context.Context, method, path string, body []byte, opts ...RequestOption) (*rawResponse, error) {
+	policy := c.retryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	} else if policy.MaxAttempts <= 0 {
+		// A caller-constructed RetryPolicy that leaves MaxAttempts at its
+		// zero value would otherwise make the loop below run zero times,
+		// returning (nil, nil) and crashing every caller that dereferences
+		// the result. Clamp rather than replace so the rest of the
+		// caller's tuning (RetryableStatusCodes, OnRetry, ...) still holds.
+		clamped := *policy
+		clamped.MaxAttempts = DefaultRetryPolicy().MaxAttempts
+		policy = &clamped
+	}
+
+	retryable := isRetryableMethod(method, newRequestOptions(opts).idempotencyKey != "")
+
+	var lastErr error
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		req, err := c.NewRequestWithContext(ctx, method, path, body, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		raw, doErr := c.doRequestRaw(req)
+
+		var retryAfter time.Duration
+		switch {
+		case doErr != nil:
+			lastErr = doErr
+		case policy.RetryableStatusCodes[raw.status]:
+			lastErr = responseError(raw.status, raw.body)
+			retryAfter = parseRetryAfter(raw.header.Get("Retry-After"))
+		default:
+			return raw, nil
+		}
+
+		isLastAttempt := attempt == policy.MaxAttempts
+		if !retryable || isLastAttempt {
+			if doErr != nil {
+				return nil, doErr
+			}
+			return raw, nil
+		}
+
+		if policy.OnRetry != nil {
+			policy.OnRetry(attempt, lastErr)
+		}
+
+		delay := policy.backoff(attempt, retryAfter)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,93 @@
+package webtenderApi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoffZeroValue(t *testing.T) {
+	// A caller-constructed RetryPolicy that only sets one field (here,
+	// nothing at all) leaves BaseDelay/MaxDelay at their zero value.
+	// backoff must not panic (rand.Int63n panics given a non-positive
+	// bound) and must return a non-negative delay.
+	policy := &RetryPolicy{}
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		d := policy.backoff(attempt, 0)
+		if d < 0 {
+			t.Fatalf("attempt %d: backoff returned negative delay %v", attempt, d)
+		}
+	}
+}
+
+func TestExecuteZeroValueMaxAttempts(t *testing.T) {
+	// A RetryPolicy{} (MaxAttempts left at its zero value) must not make
+	// execute's retry loop run zero times and return (nil, nil) -- every
+	// caller built on execute dereferences that result.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{
+		APIKey:      "test-key",
+		APISecret:   "test-secret",
+		BaseURL:     server.URL,
+		RetryPolicy: &RetryPolicy{},
+	})
+
+	resp, err := client.GetContext(context.Background(), "/v1/servers")
+	if err != nil {
+		t.Fatalf("GetContext returned error: %v", err)
+	}
+	if resp == nil {
+		t.Fatal("GetContext returned a nil ApiResponse")
+	}
+	if resp.Status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.Status)
+	}
+}
+
+func TestExecuteRetriesWithUnsetMaxAttempts(t *testing.T) {
+	// The clamp in execute should only raise MaxAttempts to the default,
+	// not discard the rest of the caller's policy -- a custom
+	// RetryableStatusCodes set alongside a forgotten MaxAttempts should
+	// still drive retries.
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{
+		APIKey:    "test-key",
+		APISecret: "test-secret",
+		BaseURL:   server.URL,
+		RetryPolicy: &RetryPolicy{
+			BaseDelay:            time.Millisecond,
+			MaxDelay:             10 * time.Millisecond,
+			RetryableStatusCodes: map[int]bool{http.StatusServiceUnavailable: true},
+		},
+	})
+
+	resp, err := client.GetContext(context.Background(), "/v1/servers")
+	if err != nil {
+		t.Fatalf("GetContext returned error: %v", err)
+	}
+	if resp.Status != http.StatusOK {
+		t.Fatalf("expected status 200 after retry, got %d", resp.Status)
+	}
+	if attempts < 2 {
+		t.Fatalf("expected at least 2 attempts, got %d", attempts)
+	}
+}
@@ -0,0 +1,113 @@
+package webtenderApi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/webtender/api-client-go/wsstream"
+)
+
+// Server mirrors the v1 /servers resource.
+type Server struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	Region    string    `json:"region,omitempty"`
+	Plan      string    `json:"plan,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateServerRequest is the payload accepted by ServersClient.Create.
+type CreateServerRequest struct {
+	Name   string `json:"name"`
+	Region string `json:"region,omitempty"`
+	Plan   string `json:"plan,omitempty"`
+}
+
+// ServerListOptions controls pagination of ServersClient.List and
+// ServersClient.ListAll.
+type ServerListOptions struct {
+	Page    int
+	PerPage int
+}
+
+func (o ServerListOptions) queryString() string {
+	q := url.Values{}
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	return q.Encode()
+}
+
+// ServersClient groups the server-related endpoints of the v1 API.
+type ServersClient struct {
+	client *Client
+}
+
+// Servers returns a client scoped to the /v1/servers resource.
+func (c *Client) Servers() *ServersClient {
+	return &ServersClient{client: c}
+}
+
+// List fetches a single page of servers.
+func (s *ServersClient) List(ctx context.Context, opts ServerListOptions) ([]Server, error) {
+	page, err := s.ListPage(ctx, opts.Page, opts.PerPage)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// ListPage fetches one page of servers along with its Link-header
+// pagination state.
+func (s *ServersClient) ListPage(ctx context.Context, page, perPage int) (*Page[Server], error) {
+	return doPage[Server](s.client, ctx, "GET", s.listPath(ServerListOptions{Page: page, PerPage: perPage}), nil)
+}
+
+// ListAll returns an Iterator that lazily walks every page of servers,
+// starting from opts.Page (or the first page, if unset), following each
+// response's Link header to find the next one.
+func (s *ServersClient) ListAll(ctx context.Context, opts ServerListOptions) *Iterator[Server] {
+	return newIterator(ctx, s.listPath(opts), func(ctx context.Context, path string) (*Page[Server], error) {
+		return doPage[Server](s.client, ctx, "GET", path, nil)
+	})
+}
+
+func (s *ServersClient) listPath(opts ServerListOptions) string {
+	path := "/v1/servers"
+	if qs := opts.queryString(); qs != "" {
+		path += "?" + qs
+	}
+	return path
+}
+
+func (s *ServersClient) Get(ctx context.Context, id string) (*Server, error) {
+	return Do[Server](s.client, ctx, "GET", "/v1/servers/"+id, nil)
+}
+
+func (s *ServersClient) Create(ctx context.Context, create CreateServerRequest) (*Server, error) {
+	body, err := json.Marshal(create)
+	if err != nil {
+		return nil, err
+	}
+	return Do[Server](s.client, ctx, "POST", "/v1/servers", body)
+}
+
+func (s *ServersClient) Delete(ctx context.Context, id string) error {
+	_, err := s.client.DeleteContext(ctx, "/v1/servers/"+id)
+	return err
+}
+
+// Console opens a live stream of serverID's console output.
+func (s *ServersClient) Console(ctx context.Context, serverID string) (*wsstream.Stream, error) {
+	return s.client.openStream(ctx, fmt.Sprintf("/v1/servers/%s/console", serverID))
+}
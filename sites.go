@@ -0,0 +1,102 @@
+package webtenderApi
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Site mirrors the v1 /sites resource.
+type Site struct {
+	ID        string    `json:"id"`
+	ServerID  string    `json:"server_id"`
+	Domain    string    `json:"domain"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateSiteRequest is the payload accepted by SitesClient.Create.
+type CreateSiteRequest struct {
+	ServerID string `json:"server_id"`
+	Domain   string `json:"domain"`
+}
+
+// SiteListOptions controls pagination of SitesClient.List and
+// SitesClient.ListAll.
+type SiteListOptions struct {
+	Page    int
+	PerPage int
+}
+
+func (o SiteListOptions) queryString() string {
+	q := url.Values{}
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	return q.Encode()
+}
+
+// SitesClient groups the site-related endpoints of the v1 API.
+type SitesClient struct {
+	client *Client
+}
+
+// Sites returns a client scoped to the /v1/sites resource.
+func (c *Client) Sites() *SitesClient {
+	return &SitesClient{client: c}
+}
+
+// List fetches a single page of sites.
+func (s *SitesClient) List(ctx context.Context, opts SiteListOptions) ([]Site, error) {
+	page, err := s.ListPage(ctx, opts.Page, opts.PerPage)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// ListPage fetches one page of sites along with its Link-header pagination
+// state.
+func (s *SitesClient) ListPage(ctx context.Context, page, perPage int) (*Page[Site], error) {
+	return doPage[Site](s.client, ctx, "GET", s.listPath(SiteListOptions{Page: page, PerPage: perPage}), nil)
+}
+
+// ListAll returns an Iterator that lazily walks every page of sites,
+// starting from opts.Page (or the first page, if unset), following each
+// response's Link header to find the next one.
+func (s *SitesClient) ListAll(ctx context.Context, opts SiteListOptions) *Iterator[Site] {
+	return newIterator(ctx, s.listPath(opts), func(ctx context.Context, path string) (*Page[Site], error) {
+		return doPage[Site](s.client, ctx, "GET", path, nil)
+	})
+}
+
+func (s *SitesClient) listPath(opts SiteListOptions) string {
+	path := "/v1/sites"
+	if qs := opts.queryString(); qs != "" {
+		path += "?" + qs
+	}
+	return path
+}
+
+func (s *SitesClient) Get(ctx context.Context, id string) (*Site, error) {
+	return Do[Site](s.client, ctx, "GET", "/v1/sites/"+id, nil)
+}
+
+func (s *SitesClient) Create(ctx context.Context, create CreateSiteRequest) (*Site, error) {
+	body, err := json.Marshal(create)
+	if err != nil {
+		return nil, err
+	}
+	return Do[Site](s.client, ctx, "POST", "/v1/sites", body)
+}
+
+func (s *SitesClient) Delete(ctx context.Context, id string) error {
+	_, err := s.client.DeleteContext(ctx, "/v1/sites/"+id)
+	return err
+}
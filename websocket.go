@@ -0,0 +1,36 @@
+package webtenderApi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/webtender/api-client-go/wsstream"
+)
+
+// openStream builds a request for path signed the same way SignRequest
+// signs ordinary HTTP requests, then hands it to wsstream to perform the
+// WebSocket upgrade.
+func (c *Client) openStream(ctx context.Context, path string) (*wsstream.Stream, error) {
+	req, err := c.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return wsstream.Open(ctx, wsstream.Dialer{
+		URL:    toWebSocketURL(req.URL.String()),
+		Header: req.Header,
+	})
+}
+
+// toWebSocketURL rewrites an http(s):// URL to its ws(s):// equivalent.
+func toWebSocketURL(u string) string {
+	switch {
+	case strings.HasPrefix(u, "https://"):
+		return "wss://" + strings.TrimPrefix(u, "https://")
+	case strings.HasPrefix(u, "http://"):
+		return "ws://" + strings.TrimPrefix(u, "http://")
+	default:
+		return u
+	}
+}
@@ -0,0 +1,109 @@
+package wsstream
+
+import (
+	"context"
+	"sync"
+)
+
+// Hub multiplexes a single Stream's messages to multiple subscribers, so
+// several consumer goroutines can each watch for different event types on
+// one connection.
+type Hub struct {
+	stream *Stream
+
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+// NewHub creates a Hub over stream. Call Run, typically in its own
+// goroutine, to start fanning out messages.
+func NewHub(stream *Stream) *Hub {
+	return &Hub{stream: stream, subs: map[*Subscription]struct{}{}}
+}
+
+// Run fans out stream's messages to subscribers until the stream closes or
+// ctx is done, at which point every subscription is closed.
+func (h *Hub) Run(ctx context.Context) {
+	for {
+		select {
+		case msg, ok := <-h.stream.Messages():
+			if !ok {
+				h.closeAll()
+				return
+			}
+			h.dispatch(msg)
+		case <-ctx.Done():
+			h.closeAll()
+			return
+		}
+	}
+}
+
+func (h *Hub) dispatch(msg Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		if sub.filter != nil && !sub.filter(msg) {
+			continue
+		}
+		select {
+		case sub.messages <- msg:
+		default:
+			// Slow consumer: drop rather than block the hub.
+		}
+	}
+}
+
+func (h *Hub) closeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		close(sub.messages)
+	}
+	h.subs = map[*Subscription]struct{}{}
+}
+
+// Subscribe registers a new subscriber. filter may be nil to receive every
+// message.
+func (h *Hub) Subscribe(filter func(Message) bool) *Subscription {
+	sub := &Subscription{
+		hub:      h,
+		filter:   filter,
+		messages: make(chan Message, 32),
+	}
+
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub
+}
+
+func (h *Hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.messages)
+	}
+}
+
+// Subscription is one consumer's view of a Hub's messages.
+type Subscription struct {
+	hub      *Hub
+	filter   func(Message) bool
+	messages chan Message
+}
+
+// Messages returns this subscription's message channel.
+func (sub *Subscription) Messages() <-chan Message {
+	return sub.messages
+}
+
+// Close unsubscribes, closing Messages.
+func (sub *Subscription) Close() {
+	sub.hub.unsubscribe(sub)
+}
@@ -0,0 +1,204 @@
+// Package wsstream provides authenticated, auto-reconnecting WebSocket
+// streams for the WebTender API's real-time endpoints (server console
+// output, deployment logs, health events).
+package wsstream
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Message is a single frame received from the server.
+type Message struct {
+	Type int
+	Data []byte
+}
+
+// Dialer describes how to open and maintain a Stream.
+type Dialer struct {
+	URL    string
+	Header http.Header
+
+	// PingInterval controls how often a ping keepalive frame is sent.
+	// Defaults to 30s.
+	PingInterval time.Duration
+
+	// MaxBackoff caps the delay between reconnect attempts. Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+// Stream is a live WebSocket connection that reconnects with exponential
+// backoff when the underlying connection drops.
+type Stream struct {
+	dialer    Dialer
+	messages  chan Message
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu  sync.Mutex
+	err error
+}
+
+// Open dials dialer.URL and runs the read/reconnect loop in the background
+// until ctx is done or the returned Stream is closed.
+func Open(ctx context.Context, dialer Dialer) (*Stream, error) {
+	if dialer.PingInterval <= 0 {
+		dialer.PingInterval = 30 * time.Second
+	}
+	if dialer.MaxBackoff <= 0 {
+		dialer.MaxBackoff = 30 * time.Second
+	}
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, dialer.URL, dialer.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Stream{
+		dialer:   dialer,
+		messages: make(chan Message, 32),
+		done:     make(chan struct{}),
+	}
+
+	go s.run(ctx, conn)
+
+	return s, nil
+}
+
+func (s *Stream) run(ctx context.Context, conn *websocket.Conn) {
+	defer close(s.messages)
+
+	attempt := 0
+	for {
+		// conn is nil after a failed reconnect below; skip straight to
+		// another backoff/dial cycle instead of handing pump a nil conn,
+		// which would panic on its first method call.
+		if conn != nil {
+			if err := s.pump(ctx, conn); err != nil {
+				s.setErr(err)
+			}
+			conn = nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		default:
+		}
+
+		attempt++
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		case <-time.After(backoff(attempt, s.dialer.MaxBackoff)):
+		}
+
+		var err error
+		conn, _, err = websocket.DefaultDialer.DialContext(ctx, s.dialer.URL, s.dialer.Header)
+		if err != nil {
+			s.setErr(err)
+			conn = nil
+			continue
+		}
+		attempt = 0
+	}
+}
+
+// pump reads frames off conn until it errs or the stream is closed, sending
+// a ping on PingInterval to keep the connection alive. It always blocks
+// until its reader goroutine has exited before returning, so run's
+// close(s.messages) can never race a still-running send on that channel.
+func (s *Stream) pump(ctx context.Context, conn *websocket.Conn) error {
+	conn.SetPongHandler(func(string) error { return nil })
+
+	pinger := time.NewTicker(s.dialer.PingInterval)
+	defer pinger.Stop()
+
+	readerDone := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(readerDone)
+		for {
+			msgType, data, err := conn.ReadMessage()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case s.messages <- Message{Type: msgType, Data: data}:
+			case <-s.done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	defer func() {
+		// Closing conn unblocks a ReadMessage call the reader goroutine may
+		// still be parked in, so it can observe ctx.Done()/s.done and exit.
+		conn.Close()
+		<-readerDone
+	}()
+
+	for {
+		select {
+		case err := <-errCh:
+			return err
+		case <-pinger.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(5*time.Second)); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.done:
+			return nil
+		}
+	}
+}
+
+// backoff computes an exponential backoff with full jitter, the same shape
+// used for HTTP retries.
+func backoff(attempt int, max time.Duration) time.Duration {
+	base := 500 * time.Millisecond
+	d := base * time.Duration(uint64(1)<<uint(attempt-1))
+	if d <= 0 || d > max {
+		d = max
+	}
+	return time.Duration(rand.Int63n(int64(d)))
+}
+
+// Messages returns the channel of received messages. It closes once the
+// stream is closed or ctx (passed to Open) is done.
+func (s *Stream) Messages() <-chan Message {
+	return s.messages
+}
+
+// Err returns the most recent connection error, if any.
+func (s *Stream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *Stream) setErr(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+}
+
+// Close signals the read loop to stop and returns without waiting for it to
+// exit; Messages is closed once the loop has stopped.
+func (s *Stream) Close() error {
+	s.closeOnce.Do(func() { close(s.done) })
+	return nil
+}
@@ -0,0 +1,55 @@
+package wsstream
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// TestStreamReconnectAfterFailedDial drives Stream.run through a real
+// dropped connection followed by a reconnect attempt that fails outright
+// (the server is gone), the exact sequence that handed pump a nil conn and
+// panicked before the fix. A panic here crashes the whole test binary,
+// which is how this test would have caught the bug.
+func TestStreamReconnectAfterFailedDial(t *testing.T) {
+	var upgrader websocket.Upgrader
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn.Close() // drop the connection immediately
+	}))
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := Open(ctx, Dialer{URL: url, MaxBackoff: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer s.Close()
+
+	// Give run() time to notice the dropped connection and reconnect once
+	// successfully, before the server disappears entirely.
+	time.Sleep(20 * time.Millisecond)
+
+	// Take the server down so the next DialContext fails, forcing run()
+	// down the failed-reconnect path.
+	server.Close()
+
+	// Give run() several backoff/dial cycles to hit that path.
+	time.Sleep(100 * time.Millisecond)
+
+	if s.Err() == nil {
+		t.Fatal("expected Err() to report a dial failure after the server was closed")
+	}
+}